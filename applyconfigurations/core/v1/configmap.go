@@ -0,0 +1,68 @@
+// Package v1 provides hand-written Server-Side Apply configuration
+// builders for the core/v1 types exposed by examples/clientset, mirroring
+// (a subset of) k8s.io/client-go/applyconfigurations/core/v1.
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConfigMapApplyConfiguration represents a Server-Side Apply declaration
+// for a corev1.ConfigMap.
+type ConfigMapApplyConfiguration struct {
+	metav1.TypeMeta              `json:",inline"`
+	ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
+	Data                         map[string]string `json:"data,omitempty"`
+	BinaryData                   map[string][]byte `json:"binaryData,omitempty"`
+	Immutable                    *bool             `json:"immutable,omitempty"`
+}
+
+// ConfigMap constructs a ConfigMapApplyConfiguration for the object with
+// the given name and namespace.
+func ConfigMap(name, namespace string) *ConfigMapApplyConfiguration {
+	return &ConfigMapApplyConfiguration{
+		TypeMeta:                     metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMetaApplyConfiguration: ObjectMetaApplyConfiguration{Name: name, Namespace: namespace},
+	}
+}
+
+func (b *ConfigMapApplyConfiguration) WithLabels(labels map[string]string) *ConfigMapApplyConfiguration {
+	b.Labels = labels
+	return b
+}
+
+func (b *ConfigMapApplyConfiguration) WithAnnotations(annotations map[string]string) *ConfigMapApplyConfiguration {
+	b.Annotations = annotations
+	return b
+}
+
+func (b *ConfigMapApplyConfiguration) WithData(data map[string]string) *ConfigMapApplyConfiguration {
+	b.Data = data
+	return b
+}
+
+func (b *ConfigMapApplyConfiguration) WithBinaryData(data map[string][]byte) *ConfigMapApplyConfiguration {
+	b.BinaryData = data
+	return b
+}
+
+func (b *ConfigMapApplyConfiguration) WithImmutable(immutable bool) *ConfigMapApplyConfiguration {
+	b.Immutable = &immutable
+	return b
+}
+
+// GetName implements client.ApplyConfiguration[*corev1.ConfigMap].
+func (b *ConfigMapApplyConfiguration) GetName() *string {
+	if b.Name == "" {
+		return nil
+	}
+	return &b.Name
+}
+
+// GetNamespace implements client.ApplyConfiguration[*corev1.ConfigMap].
+func (b *ConfigMapApplyConfiguration) GetNamespace() *string {
+	if b.Namespace == "" {
+		return nil
+	}
+	return &b.Namespace
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EndpointsApplyConfiguration represents a Server-Side Apply declaration
+// for a corev1.Endpoints.
+type EndpointsApplyConfiguration struct {
+	metav1.TypeMeta              `json:",inline"`
+	ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
+	Subsets                      []corev1.EndpointSubset `json:"subsets,omitempty"`
+}
+
+// Endpoints constructs an EndpointsApplyConfiguration for the object with
+// the given name and namespace.
+func Endpoints(name, namespace string) *EndpointsApplyConfiguration {
+	return &EndpointsApplyConfiguration{
+		TypeMeta:                     metav1.TypeMeta{APIVersion: "v1", Kind: "Endpoints"},
+		ObjectMetaApplyConfiguration: ObjectMetaApplyConfiguration{Name: name, Namespace: namespace},
+	}
+}
+
+func (b *EndpointsApplyConfiguration) WithLabels(labels map[string]string) *EndpointsApplyConfiguration {
+	b.Labels = labels
+	return b
+}
+
+func (b *EndpointsApplyConfiguration) WithAnnotations(annotations map[string]string) *EndpointsApplyConfiguration {
+	b.Annotations = annotations
+	return b
+}
+
+func (b *EndpointsApplyConfiguration) WithSubsets(subsets ...corev1.EndpointSubset) *EndpointsApplyConfiguration {
+	b.Subsets = append(b.Subsets, subsets...)
+	return b
+}
+
+// GetName implements client.ApplyConfiguration[*corev1.Endpoints].
+func (b *EndpointsApplyConfiguration) GetName() *string {
+	if b.Name == "" {
+		return nil
+	}
+	return &b.Name
+}
+
+// GetNamespace implements client.ApplyConfiguration[*corev1.Endpoints].
+func (b *EndpointsApplyConfiguration) GetNamespace() *string {
+	if b.Namespace == "" {
+		return nil
+	}
+	return &b.Namespace
+}
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NamespaceApplyConfiguration represents a Server-Side Apply declaration
+// for a corev1.Namespace.
+type NamespaceApplyConfiguration struct {
+	metav1.TypeMeta              `json:",inline"`
+	ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
+	Spec                         *corev1.NamespaceSpec `json:"spec,omitempty"`
+}
+
+// Namespace constructs a NamespaceApplyConfiguration for the object with
+// the given name.
+func Namespace(name string) *NamespaceApplyConfiguration {
+	return &NamespaceApplyConfiguration{
+		TypeMeta:                     metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+		ObjectMetaApplyConfiguration: ObjectMetaApplyConfiguration{Name: name},
+	}
+}
+
+func (b *NamespaceApplyConfiguration) WithLabels(labels map[string]string) *NamespaceApplyConfiguration {
+	b.Labels = labels
+	return b
+}
+
+func (b *NamespaceApplyConfiguration) WithAnnotations(annotations map[string]string) *NamespaceApplyConfiguration {
+	b.Annotations = annotations
+	return b
+}
+
+func (b *NamespaceApplyConfiguration) WithSpec(spec corev1.NamespaceSpec) *NamespaceApplyConfiguration {
+	b.Spec = &spec
+	return b
+}
+
+// GetName implements client.ApplyConfiguration[*corev1.Namespace].
+func (b *NamespaceApplyConfiguration) GetName() *string {
+	if b.Name == "" {
+		return nil
+	}
+	return &b.Name
+}
+
+// GetNamespace implements client.ApplyConfiguration[*corev1.Namespace]; a
+// Namespace is itself cluster-scoped, so this always returns nil.
+func (b *NamespaceApplyConfiguration) GetNamespace() *string {
+	return nil
+}
@@ -0,0 +1,16 @@
+package v1
+
+// ObjectMetaApplyConfiguration is a restricted mirror of
+// k8s.io/client-go/applyconfigurations/meta/v1.ObjectMetaApplyConfiguration,
+// exposing only the metadata fields the builders in this package need
+// (name, namespace, labels and annotations) instead of embedding the full
+// metav1.ObjectMeta. Embedding metav1.ObjectMeta by value would also
+// promote ResourceVersion, UID, OwnerReferences, ManagedFields, ... as
+// directly settable fields, which would then be silently serialized into
+// the Server-Side Apply patch body.
+type ObjectMetaApplyConfiguration struct {
+	Name        string            `json:"name,omitempty"`
+	Namespace   string            `json:"namespace,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PodApplyConfiguration represents a Server-Side Apply declaration for a
+// corev1.Pod.
+type PodApplyConfiguration struct {
+	metav1.TypeMeta              `json:",inline"`
+	ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
+	Spec                         *corev1.PodSpec `json:"spec,omitempty"`
+}
+
+// Pod constructs a PodApplyConfiguration for the object with the given
+// name and namespace.
+func Pod(name, namespace string) *PodApplyConfiguration {
+	return &PodApplyConfiguration{
+		TypeMeta:                     metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMetaApplyConfiguration: ObjectMetaApplyConfiguration{Name: name, Namespace: namespace},
+	}
+}
+
+func (b *PodApplyConfiguration) WithLabels(labels map[string]string) *PodApplyConfiguration {
+	b.Labels = labels
+	return b
+}
+
+func (b *PodApplyConfiguration) WithAnnotations(annotations map[string]string) *PodApplyConfiguration {
+	b.Annotations = annotations
+	return b
+}
+
+func (b *PodApplyConfiguration) WithSpec(spec corev1.PodSpec) *PodApplyConfiguration {
+	b.Spec = &spec
+	return b
+}
+
+// GetName implements client.ApplyConfiguration[*corev1.Pod].
+func (b *PodApplyConfiguration) GetName() *string {
+	if b.Name == "" {
+		return nil
+	}
+	return &b.Name
+}
+
+// GetNamespace implements client.ApplyConfiguration[*corev1.Pod].
+func (b *PodApplyConfiguration) GetNamespace() *string {
+	if b.Namespace == "" {
+		return nil
+	}
+	return &b.Namespace
+}
@@ -0,0 +1,72 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SecretApplyConfiguration represents a Server-Side Apply declaration for a
+// corev1.Secret.
+type SecretApplyConfiguration struct {
+	metav1.TypeMeta              `json:",inline"`
+	ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
+	Data                         map[string][]byte  `json:"data,omitempty"`
+	StringData                   map[string]string  `json:"stringData,omitempty"`
+	Type                         *corev1.SecretType `json:"type,omitempty"`
+	Immutable                    *bool              `json:"immutable,omitempty"`
+}
+
+// Secret constructs a SecretApplyConfiguration for the object with the
+// given name and namespace.
+func Secret(name, namespace string) *SecretApplyConfiguration {
+	return &SecretApplyConfiguration{
+		TypeMeta:                     metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMetaApplyConfiguration: ObjectMetaApplyConfiguration{Name: name, Namespace: namespace},
+	}
+}
+
+func (b *SecretApplyConfiguration) WithLabels(labels map[string]string) *SecretApplyConfiguration {
+	b.Labels = labels
+	return b
+}
+
+func (b *SecretApplyConfiguration) WithAnnotations(annotations map[string]string) *SecretApplyConfiguration {
+	b.Annotations = annotations
+	return b
+}
+
+func (b *SecretApplyConfiguration) WithData(data map[string][]byte) *SecretApplyConfiguration {
+	b.Data = data
+	return b
+}
+
+func (b *SecretApplyConfiguration) WithStringData(data map[string]string) *SecretApplyConfiguration {
+	b.StringData = data
+	return b
+}
+
+func (b *SecretApplyConfiguration) WithType(t corev1.SecretType) *SecretApplyConfiguration {
+	b.Type = &t
+	return b
+}
+
+func (b *SecretApplyConfiguration) WithImmutable(immutable bool) *SecretApplyConfiguration {
+	b.Immutable = &immutable
+	return b
+}
+
+// GetName implements client.ApplyConfiguration[*corev1.Secret].
+func (b *SecretApplyConfiguration) GetName() *string {
+	if b.Name == "" {
+		return nil
+	}
+	return &b.Name
+}
+
+// GetNamespace implements client.ApplyConfiguration[*corev1.Secret].
+func (b *SecretApplyConfiguration) GetNamespace() *string {
+	if b.Namespace == "" {
+		return nil
+	}
+	return &b.Namespace
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceApplyConfiguration represents a Server-Side Apply declaration for
+// a corev1.Service.
+type ServiceApplyConfiguration struct {
+	metav1.TypeMeta              `json:",inline"`
+	ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
+	Spec                         *corev1.ServiceSpec `json:"spec,omitempty"`
+}
+
+// Service constructs a ServiceApplyConfiguration for the object with the
+// given name and namespace.
+func Service(name, namespace string) *ServiceApplyConfiguration {
+	return &ServiceApplyConfiguration{
+		TypeMeta:                     metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMetaApplyConfiguration: ObjectMetaApplyConfiguration{Name: name, Namespace: namespace},
+	}
+}
+
+func (b *ServiceApplyConfiguration) WithLabels(labels map[string]string) *ServiceApplyConfiguration {
+	b.Labels = labels
+	return b
+}
+
+func (b *ServiceApplyConfiguration) WithAnnotations(annotations map[string]string) *ServiceApplyConfiguration {
+	b.Annotations = annotations
+	return b
+}
+
+func (b *ServiceApplyConfiguration) WithSpec(spec corev1.ServiceSpec) *ServiceApplyConfiguration {
+	b.Spec = &spec
+	return b
+}
+
+// GetName implements client.ApplyConfiguration[*corev1.Service].
+func (b *ServiceApplyConfiguration) GetName() *string {
+	if b.Name == "" {
+		return nil
+	}
+	return &b.Name
+}
+
+// GetNamespace implements client.ApplyConfiguration[*corev1.Service].
+func (b *ServiceApplyConfiguration) GetNamespace() *string {
+	if b.Namespace == "" {
+		return nil
+	}
+	return &b.Namespace
+}
@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"github.com/wzshiming/k8s-client-go/client"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Indexers is a typed re-export of cache.Indexers, the map of index name to
+// IndexFunc passed to NewSharedIndexInformer.
+type Indexers = cache.Indexers
+
+// Indexer is Store plus the ability to look objects up by an index other
+// than their key, matching the standard client-go cache.Indexer.
+type Indexer[T client.Object] interface {
+	Store[T]
+
+	// Index returns the stored objects whose set of indexed values
+	// intersects the set of indexed values of obj, for the named index.
+	Index(indexName string, obj T) ([]T, error)
+
+	// IndexKeys returns the storage keys of the stored objects whose set of
+	// indexed values for the named index includes the given indexed value.
+	IndexKeys(indexName, indexedValue string) ([]string, error)
+
+	// ListIndexFuncValues returns all the indexed values of the given index.
+	ListIndexFuncValues(indexName string) []string
+
+	// ByIndex returns the stored objects whose set of indexed values for
+	// the named index includes the given indexed value.
+	ByIndex(indexName, indexedValue string) ([]T, error)
+
+	// AddIndexers adds more indexers to this store. It must be called
+	// before adding any objects to the store.
+	AddIndexers(newIndexers Indexers) error
+
+	// RawIndexer returns the underlying cache.Indexer, for callers (like
+	// NewLister) that need to hand it to raw client-go helpers such as
+	// cache.ListAll.
+	RawIndexer() cache.Indexer
+}
+
+type indexer[T client.Object] struct {
+	store[T]
+	raw cache.Indexer
+}
+
+func newIndexer[T client.Object](raw cache.Indexer) Indexer[T] {
+	return indexer[T]{store: store[T]{raw}, raw: raw}
+}
+
+func (s indexer[T]) Index(indexName string, obj T) ([]T, error) {
+	items, err := s.raw.Index(indexName, obj)
+	if err != nil {
+		return nil, err
+	}
+	return toTypedSlice[T](items), nil
+}
+
+func (s indexer[T]) IndexKeys(indexName, indexedValue string) ([]string, error) {
+	return s.raw.IndexKeys(indexName, indexedValue)
+}
+
+func (s indexer[T]) ListIndexFuncValues(indexName string) []string {
+	return s.raw.ListIndexFuncValues(indexName)
+}
+
+func (s indexer[T]) ByIndex(indexName, indexedValue string) ([]T, error) {
+	items, err := s.raw.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	return toTypedSlice[T](items), nil
+}
+
+func (s indexer[T]) AddIndexers(newIndexers Indexers) error {
+	return s.raw.AddIndexers(newIndexers)
+}
+
+func (s indexer[T]) RawIndexer() cache.Indexer {
+	return s.raw
+}
+
+func toTypedSlice[T client.Object](items []interface{}) []T {
+	list := make([]T, 0, len(items))
+	for _, item := range items {
+		list = append(list, item.(T))
+	}
+	return list
+}
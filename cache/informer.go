@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/wzshiming/k8s-client-go/client"
+	"github.com/wzshiming/k8s-client-go/dynamic"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/tools/cache"
@@ -110,21 +112,27 @@ func (s store[T]) Replace(items []T, resourceVersion string) error {
 
 type Controller = cache.Controller
 
-func Informer[T client.Object, L client.List](ctx context.Context, c client.Interface[T, L], resyncPeriod time.Duration, h ResourceEventHandler[T], optionsModifier func(options *metav1.ListOptions)) (Store[T], Controller) {
-	var handler cache.ResourceEventHandler
-	if h != nil {
-		handler = cache.ResourceEventHandlerFuncs{
-			AddFunc: func(obj interface{}) {
-				h.OnAdd(obj.(T))
-			},
-			UpdateFunc: func(oldObj, newObj interface{}) {
-				h.OnUpdate(oldObj.(T), newObj.(T))
-			},
-			DeleteFunc: func(obj interface{}) {
-				h.OnDelete(obj.(T))
-			},
-		}
+// toRawHandler adapts a typed ResourceEventHandler to the untyped
+// cache.ResourceEventHandler the underlying client-go informers expect.
+func toRawHandler[T client.Object](h ResourceEventHandler[T]) cache.ResourceEventHandler {
+	if h == nil {
+		return nil
+	}
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			h.OnAdd(obj.(T))
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			h.OnUpdate(oldObj.(T), newObj.(T))
+		},
+		DeleteFunc: func(obj interface{}) {
+			h.OnDelete(obj.(T))
+		},
 	}
+}
+
+func Informer[T client.Object, L client.List](ctx context.Context, c client.Interface[T, L], resyncPeriod time.Duration, h ResourceEventHandler[T], optionsModifier func(options *metav1.ListOptions)) (Store[T], Controller) {
+	handler := toRawHandler(h)
 	var (
 		t T
 	)
@@ -151,3 +159,17 @@ func Informer[T client.Object, L client.List](ctx context.Context, c client.Inte
 	)
 	return store[T]{s}, controller
 }
+
+// MetadataInformer is Informer specialized to *metav1.PartialObjectMetadata,
+// so callers building a metadata-only informer (see client.NewMetadataClient)
+// don't need to spell out the type parameters themselves.
+func MetadataInformer(ctx context.Context, c client.Interface[*metav1.PartialObjectMetadata, *metav1.PartialObjectMetadataList], resyncPeriod time.Duration, h ResourceEventHandler[*metav1.PartialObjectMetadata], optionsModifier func(options *metav1.ListOptions)) (Store[*metav1.PartialObjectMetadata], Controller) {
+	return Informer[*metav1.PartialObjectMetadata, *metav1.PartialObjectMetadataList](ctx, c, resyncPeriod, h, optionsModifier)
+}
+
+// DynamicInformer is Informer specialized to *unstructured.Unstructured, so
+// an informer for a resource only known by GroupVersionResource (see the
+// dynamic package) can be built in a single line.
+func DynamicInformer(ctx context.Context, c client.Interface[*unstructured.Unstructured, *dynamic.UnstructuredList], resyncPeriod time.Duration, h ResourceEventHandler[*unstructured.Unstructured], optionsModifier func(options *metav1.ListOptions)) (Store[*unstructured.Unstructured], Controller) {
+	return Informer[*unstructured.Unstructured, *dynamic.UnstructuredList](ctx, c, resyncPeriod, h, optionsModifier)
+}
@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"github.com/wzshiming/k8s-client-go/client"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Lister helps list objects of type T from an Indexer, matching the
+// standard client-go generated listers.
+type Lister[T client.Object] interface {
+	// List lists all objects matching selector.
+	List(selector labels.Selector) (ret []T, err error)
+
+	// Namespace returns a lister scoped to the given namespace.
+	Namespace(namespace string) NamespaceLister[T]
+}
+
+// NamespaceLister helps list and get objects of type T from an Indexer,
+// restricted to one namespace.
+type NamespaceLister[T client.Object] interface {
+	// List lists all objects matching selector in the namespace.
+	List(selector labels.Selector) (ret []T, err error)
+
+	// Get retrieves the object with the given name in the namespace.
+	Get(name string) (T, error)
+}
+
+type lister[T client.Object] struct {
+	indexer  cache.Indexer
+	resource schema.GroupResource
+}
+
+// NewLister returns a Lister backed by idx. resource is only used to build
+// an accurate errors.NewNotFound error out of NamespaceLister.Get. idx can
+// be any Indexer[T], including a hand-rolled fake, since it's read through
+// the public RawIndexer method rather than downcast to a concrete type.
+func NewLister[T client.Object](idx Indexer[T], resource schema.GroupResource) Lister[T] {
+	return lister[T]{indexer: idx.RawIndexer(), resource: resource}
+}
+
+func (l lister[T]) List(selector labels.Selector) (ret []T, err error) {
+	err = cache.ListAll(l.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(T))
+	})
+	return ret, err
+}
+
+func (l lister[T]) Namespace(namespace string) NamespaceLister[T] {
+	return namespaceLister[T]{indexer: l.indexer, resource: l.resource, namespace: namespace}
+}
+
+type namespaceLister[T client.Object] struct {
+	indexer   cache.Indexer
+	resource  schema.GroupResource
+	namespace string
+}
+
+func (l namespaceLister[T]) List(selector labels.Selector) (ret []T, err error) {
+	err = cache.ListAllByNamespace(l.indexer, l.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(T))
+	})
+	return ret, err
+}
+
+func (l namespaceLister[T]) Get(name string) (result T, err error) {
+	key := name
+	if l.namespace != "" {
+		key = l.namespace + "/" + name
+	}
+	obj, exists, err := l.indexer.GetByKey(key)
+	if err != nil {
+		return result, err
+	}
+	if !exists {
+		return result, apierrors.NewNotFound(l.resource, name)
+	}
+	return obj.(T), nil
+}
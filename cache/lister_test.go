@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TestNewListerRoundTrip exercises NewLister's RawIndexer lookup against a
+// real cache.Indexer wrapped in indexer[T], then checks that
+// NamespaceLister.Get maps a missing key to an apierrors.NewNotFound error
+// carrying the given resource.
+func TestNewListerRoundTrip(t *testing.T) {
+	raw := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{
+		cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+	})
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns"}}
+	if err := raw.Add(pod); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	idx := newIndexer[*corev1.Pod](raw)
+	l := NewLister[*corev1.Pod](idx, schema.GroupResource{Resource: "pods"})
+
+	got, err := l.Namespace("ns").Get("a")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Name != "a" {
+		t.Fatalf("expected pod %q, got %q", "a", got.Name)
+	}
+
+	all, err := l.List(labels.Everything())
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(all) != 1 {
+		t.Fatalf("expected 1 pod, got %d", len(all))
+	}
+
+	_, err = l.Namespace("ns").Get("missing")
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected a NotFound error, got %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wzshiming/k8s-client-go/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MultiNamespaceInformer runs one Informer per namespace in namespaces and
+// presents their combined result as a single Store[T], so a caller that
+// only cares about a fixed set of namespaces doesn't have to pay for a
+// cluster-wide list/watch. newForNamespace is typically a partially
+// applied client constructor, e.g. func(ns string) client.Interface[...] {
+// return clientset.Pods(ns) }.
+func MultiNamespaceInformer[T client.Object, L client.List](ctx context.Context, namespaces []string, newForNamespace func(namespace string) client.Interface[T, L], resyncPeriod time.Duration, h ResourceEventHandler[T], optionsModifier func(options *metav1.ListOptions)) (Store[T], []Controller) {
+	stores := make([]Store[T], 0, len(namespaces))
+	controllers := make([]Controller, 0, len(namespaces))
+	for _, ns := range namespaces {
+		s, controller := Informer[T, L](ctx, newForNamespace(ns), resyncPeriod, h, optionsModifier)
+		stores = append(stores, s)
+		controllers = append(controllers, controller)
+	}
+	return multiStore[T]{stores: stores}, controllers
+}
+
+// multiStore presents several per-namespace Store[T] as a single Store[T].
+// Mutating methods are never called by an informer's own machinery, so
+// Add/Update/Delete/Replace act on the first store only; they exist to
+// satisfy Store[T], not for callers to rely on.
+type multiStore[T client.Object] struct {
+	stores []Store[T]
+}
+
+// errNoNamespaces is returned by multiStore's mutating methods when it was
+// built from zero namespaces, instead of panicking on m.stores[0].
+var errNoNamespaces = fmt.Errorf("multiStore: no namespaces configured")
+
+func (m multiStore[T]) Add(obj T) error {
+	if len(m.stores) == 0 {
+		return errNoNamespaces
+	}
+	return m.stores[0].Add(obj)
+}
+
+func (m multiStore[T]) Update(obj T) error {
+	if len(m.stores) == 0 {
+		return errNoNamespaces
+	}
+	return m.stores[0].Update(obj)
+}
+
+func (m multiStore[T]) Delete(obj T) error {
+	if len(m.stores) == 0 {
+		return errNoNamespaces
+	}
+	return m.stores[0].Delete(obj)
+}
+
+func (m multiStore[T]) List() []T {
+	var all []T
+	for _, s := range m.stores {
+		all = append(all, s.List()...)
+	}
+	return all
+}
+
+func (m multiStore[T]) ListKeys() []string {
+	var all []string
+	for _, s := range m.stores {
+		all = append(all, s.ListKeys()...)
+	}
+	return all
+}
+
+func (m multiStore[T]) Get(obj T) (item T, exists bool, err error) {
+	for _, s := range m.stores {
+		if item, exists, err = s.Get(obj); exists || err != nil {
+			return item, exists, err
+		}
+	}
+	return item, false, nil
+}
+
+func (m multiStore[T]) GetByKey(key string) (item T, exists bool, err error) {
+	for _, s := range m.stores {
+		if item, exists, err = s.GetByKey(key); exists || err != nil {
+			return item, exists, err
+		}
+	}
+	return item, false, nil
+}
+
+func (m multiStore[T]) Replace(items []T, resourceVersion string) error {
+	if len(m.stores) == 0 {
+		return errNoNamespaces
+	}
+	return m.stores[0].Replace(items, resourceVersion)
+}
+
+func (m multiStore[T]) Resync() error {
+	for _, s := range m.stores {
+		if err := s.Resync(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"sort"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newTestStore(pods ...*corev1.Pod) Store[*corev1.Pod] {
+	s := store[*corev1.Pod]{cache.NewStore(cache.MetaNamespaceKeyFunc)}
+	for _, pod := range pods {
+		if err := s.Add(pod); err != nil {
+			panic(err)
+		}
+	}
+	return s
+}
+
+// TestMultiStoreMergesAcrossNamespaces checks List and Get merge results
+// from every per-namespace store instead of only the first one.
+func TestMultiStoreMergesAcrossNamespaces(t *testing.T) {
+	a := newTestStore(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns-a"}})
+	b := newTestStore(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "ns-b"}})
+	m := multiStore[*corev1.Pod]{stores: []Store[*corev1.Pod]{a, b}}
+
+	names := make([]string, 0, 2)
+	for _, pod := range m.List() {
+		names = append(names, pod.Namespace+"/"+pod.Name)
+	}
+	sort.Strings(names)
+	if want := []string{"ns-a/a", "ns-b/b"}; !equalStrings(names, want) {
+		t.Fatalf("expected List to merge both namespaces, got %v, want %v", names, want)
+	}
+
+	got, exists, err := m.GetByKey("ns-b/b")
+	if err != nil || !exists {
+		t.Fatalf("GetByKey(ns-b/b): exists=%v err=%v", exists, err)
+	}
+	if got.Name != "b" {
+		t.Fatalf("expected pod %q, got %q", "b", got.Name)
+	}
+
+	_, exists, err = m.GetByKey("ns-a/missing")
+	if err != nil || exists {
+		t.Fatalf("expected a clean miss for an unknown key, got exists=%v err=%v", exists, err)
+	}
+}
+
+// TestMultiStoreEmptyNamespacesErrorsInsteadOfPanicking checks that a
+// multiStore built from zero namespaces reports a clean error on mutation
+// rather than panicking on stores[0].
+func TestMultiStoreEmptyNamespacesErrorsInsteadOfPanicking(t *testing.T) {
+	var m multiStore[*corev1.Pod]
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns"}}
+
+	if err := m.Add(pod); err != errNoNamespaces {
+		t.Fatalf("Add: expected errNoNamespaces, got %v", err)
+	}
+	if err := m.Update(pod); err != errNoNamespaces {
+		t.Fatalf("Update: expected errNoNamespaces, got %v", err)
+	}
+	if err := m.Delete(pod); err != errNoNamespaces {
+		t.Fatalf("Delete: expected errNoNamespaces, got %v", err)
+	}
+	if err := m.Replace(nil, ""); err != errNoNamespaces {
+		t.Fatalf("Replace: expected errNoNamespaces, got %v", err)
+	}
+
+	if got := m.List(); got != nil {
+		t.Fatalf("expected List to return nil for zero namespaces, got %v", got)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/wzshiming/k8s-client-go/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+// HandlerRegistration is returned by AddEventHandler(WithResyncPeriod) and
+// can be passed to the underlying informer's RemoveEventHandler.
+type HandlerRegistration = cache.ResourceEventHandlerRegistration
+
+// SharedIndexInformer is a typed wrapper around client-go's
+// cache.SharedIndexInformer: unlike Informer, a single instance can fan
+// events out to multiple independently-registered handlers and exposes its
+// backing store as an indexed Indexer[T] rather than a plain Store[T].
+type SharedIndexInformer[T client.Object] interface {
+	// AddEventHandler adds an event handler using this informer's resync
+	// period, and can be called more than once to fan events out to
+	// multiple independent handlers.
+	AddEventHandler(handler ResourceEventHandler[T]) (HandlerRegistration, error)
+
+	// AddEventHandlerWithResyncPeriod adds an event handler with its own
+	// resync period, overriding the informer's default.
+	AddEventHandlerWithResyncPeriod(handler ResourceEventHandler[T], resyncPeriod time.Duration) (HandlerRegistration, error)
+
+	// HasSynced returns true once the informer's store has been fully
+	// populated by an initial list.
+	HasSynced() bool
+
+	// LastSyncResourceVersion returns the resourceVersion observed when
+	// the informer's store was last updated.
+	LastSyncResourceVersion() string
+
+	// GetIndexer returns the informer's backing store as an Indexer[T].
+	GetIndexer() Indexer[T]
+
+	// Run starts and runs the shared informer, blocking until stopCh is
+	// closed.
+	Run(stopCh <-chan struct{})
+}
+
+type sharedIndexInformer[T client.Object] struct {
+	cache.SharedIndexInformer
+}
+
+// NewSharedIndexInformer builds a SharedIndexInformer[T] over c, indexed by
+// indexers, matching the standard client-go informer/indexer/lister
+// pattern used by controllers and controller-runtime.
+func NewSharedIndexInformer[T client.Object, L client.List](ctx context.Context, c client.Interface[T, L], resyncPeriod time.Duration, indexers Indexers, optionsModifier func(options *metav1.ListOptions)) SharedIndexInformer[T] {
+	var t T
+	t = reflect.New(reflect.TypeOf(t).Elem()).Interface().(T)
+
+	informer := cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if optionsModifier != nil {
+					optionsModifier(&options)
+				}
+				return c.List(ctx, options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if optionsModifier != nil {
+					optionsModifier(&options)
+				}
+				return c.Watch(ctx, options)
+			},
+		},
+		t,
+		resyncPeriod,
+		indexers,
+	)
+	return &sharedIndexInformer[T]{SharedIndexInformer: informer}
+}
+
+func (s *sharedIndexInformer[T]) AddEventHandler(h ResourceEventHandler[T]) (HandlerRegistration, error) {
+	return s.SharedIndexInformer.AddEventHandler(toRawHandler(h))
+}
+
+func (s *sharedIndexInformer[T]) AddEventHandlerWithResyncPeriod(h ResourceEventHandler[T], resyncPeriod time.Duration) (HandlerRegistration, error) {
+	return s.SharedIndexInformer.AddEventHandlerWithResyncPeriod(toRawHandler(h), resyncPeriod)
+}
+
+func (s *sharedIndexInformer[T]) GetIndexer() Indexer[T] {
+	return newIndexer[T](s.SharedIndexInformer.GetIndexer())
+}
@@ -0,0 +1,113 @@
+package cache
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/wzshiming/k8s-client-go/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// TransformFunc is invoked on every object right before it is inserted
+// into an informer's store, so callers can strip managed fields, large
+// annotations or unused status subtrees to shrink cache memory.
+type TransformFunc[T client.Object] func(obj T) (T, error)
+
+// WithTransform wraps c so every object returned by List or delivered by
+// Watch has first been passed through fn. Pass the result to Informer (or
+// NewSharedIndexInformer) in place of c.
+func WithTransform[T client.Object, L client.List](c client.Interface[T, L], fn TransformFunc[T]) client.Interface[T, L] {
+	if fn == nil {
+		return c
+	}
+	return &transformingClient[T, L]{Interface: c, fn: fn}
+}
+
+type transformingClient[T client.Object, L client.List] struct {
+	client.Interface[T, L]
+	fn TransformFunc[T]
+}
+
+func (c *transformingClient[T, L]) List(ctx context.Context, opts metav1.ListOptions) (result L, err error) {
+	result, err = c.Interface.List(ctx, opts)
+	if err != nil {
+		return result, err
+	}
+	if err := transformItems(result, c.fn); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+func (c *transformingClient[T, L]) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	w, err := c.Interface.Watch(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return newTransformingWatch(w, c.fn), nil
+}
+
+// transformItems applies fn in place to every element of list.Items, found
+// by reflection since the List constraint doesn't expose an Items accessor.
+func transformItems[T client.Object](list any, fn TransformFunc[T]) error {
+	rv := reflect.ValueOf(list)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	items := rv.FieldByName("Items")
+	if !items.IsValid() || items.Kind() != reflect.Slice {
+		return nil
+	}
+	for i := 0; i < items.Len(); i++ {
+		elem := items.Index(i)
+		transformed, err := fn(elem.Addr().Interface().(T))
+		if err != nil {
+			return err
+		}
+		elem.Set(reflect.ValueOf(transformed).Elem())
+	}
+	return nil
+}
+
+type transformingWatch[T client.Object] struct {
+	watch.Interface
+	fn     TransformFunc[T]
+	result chan watch.Event
+}
+
+func newTransformingWatch[T client.Object](w watch.Interface, fn TransformFunc[T]) watch.Interface {
+	tw := &transformingWatch[T]{Interface: w, fn: fn, result: make(chan watch.Event)}
+	go tw.run()
+	return tw
+}
+
+func (w *transformingWatch[T]) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+func (w *transformingWatch[T]) run() {
+	defer close(w.result)
+	for event := range w.Interface.ResultChan() {
+		if obj, ok := event.Object.(T); ok {
+			if transformed, err := w.fn(obj); err == nil {
+				event.Object = transformed
+			}
+		}
+		w.result <- event
+	}
+}
+
+// WithSelector returns an optionsModifier that sets a label and/or field
+// selector on every List/Watch call, so different informers (typically one
+// per GVR) in the same process can each carry their own selector.
+func WithSelector(labelSelector, fieldSelector string) func(options *metav1.ListOptions) {
+	return func(options *metav1.ListOptions) {
+		if labelSelector != "" {
+			options.LabelSelector = labelSelector
+		}
+		if fieldSelector != "" {
+			options.FieldSelector = fieldSelector
+		}
+	}
+}
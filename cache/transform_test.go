@@ -0,0 +1,45 @@
+package cache
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestTransformItems exercises the reflection-based Items lookup
+// transformItems relies on since the client.List constraint doesn't expose
+// an Items accessor directly.
+func TestTransformItems(t *testing.T) {
+	list := &corev1.PodList{
+		Items: []corev1.Pod{
+			{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "b"}},
+		},
+	}
+
+	err := transformItems(list, func(pod *corev1.Pod) (*corev1.Pod, error) {
+		pod.Annotations = map[string]string{"seen": "true"}
+		return pod, nil
+	})
+	if err != nil {
+		t.Fatalf("transformItems: %v", err)
+	}
+
+	for _, item := range list.Items {
+		if item.Annotations["seen"] != "true" {
+			t.Fatalf("expected item %q to be transformed in place, got %+v", item.Name, item)
+		}
+	}
+}
+
+// TestTransformItemsIgnoresListsWithoutItems checks the type without an
+// Items field falls back to a no-op instead of panicking.
+func TestTransformItemsIgnoresListsWithoutItems(t *testing.T) {
+	if err := transformItems(&metav1.Status{}, func(pod *corev1.Pod) (*corev1.Pod, error) {
+		t.Fatal("fn should not be called")
+		return pod, nil
+	}); err != nil {
+		t.Fatalf("transformItems: %v", err)
+	}
+}
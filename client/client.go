@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"reflect"
 	"time"
 
@@ -40,6 +41,12 @@ type Interface[T Object, L List] interface {
 
 	// Patch applies the patch and returns the patched resource.
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result T, err error)
+
+	// Apply performs a Server-Side Apply of cfg and returns the resulting resource.
+	Apply(ctx context.Context, cfg ApplyConfiguration[T], opts metav1.ApplyOptions) (result T, err error)
+
+	// ApplyStatus performs a Server-Side Apply of cfg against the status subresource.
+	ApplyStatus(ctx context.Context, cfg ApplyConfiguration[T], opts metav1.ApplyOptions) (result T, err error)
 }
 
 type Object interface {
@@ -52,6 +59,18 @@ type List interface {
 	metav1.ListMetaAccessor
 }
 
+// ApplyConfiguration is implemented by hand-written or generated apply
+// configuration builders (see the applyconfigurations subpackages) for a
+// given resource type T, so Apply/ApplyStatus can address the object they
+// describe.
+type ApplyConfiguration[T Object] interface {
+	// GetName returns the name of the object being applied, or nil if unset.
+	GetName() *string
+
+	// GetNamespace returns the namespace of the object being applied, or nil if unset.
+	GetNamespace() *string
+}
+
 // client implements client[Object, List]
 type client[T Object, L List] struct {
 	scheme         *runtime.Scheme
@@ -82,6 +101,63 @@ func NewClient[T Object, L List](scheme *runtime.Scheme, parameterCodec runtime.
 	}
 }
 
+// errorClient is an Interface[T, L] whose every method returns a fixed
+// error. It lets accessor methods that build their own rest.Interface on
+// demand (e.g. for an ad hoc GroupVersionResource) report a construction
+// failure at call time without changing their signature to return an error.
+type errorClient[T Object, L List] struct {
+	err error
+}
+
+// NewErrorClient returns an Interface[T, L] whose every method returns err.
+func NewErrorClient[T Object, L List](err error) Interface[T, L] {
+	return &errorClient[T, L]{err: err}
+}
+
+func (c *errorClient[T, L]) Get(ctx context.Context, name string, options metav1.GetOptions) (result T, err error) {
+	return result, c.err
+}
+
+func (c *errorClient[T, L]) List(ctx context.Context, opts metav1.ListOptions) (result L, err error) {
+	return result, c.err
+}
+
+func (c *errorClient[T, L]) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return nil, c.err
+}
+
+func (c *errorClient[T, L]) Create(ctx context.Context, cr T, opts metav1.CreateOptions) (result T, err error) {
+	return result, c.err
+}
+
+func (c *errorClient[T, L]) Update(ctx context.Context, cr T, opts metav1.UpdateOptions) (result T, err error) {
+	return result, c.err
+}
+
+func (c *errorClient[T, L]) UpdateStatus(ctx context.Context, cr T, opts metav1.UpdateOptions) (result T, err error) {
+	return result, c.err
+}
+
+func (c *errorClient[T, L]) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.err
+}
+
+func (c *errorClient[T, L]) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.err
+}
+
+func (c *errorClient[T, L]) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result T, err error) {
+	return result, c.err
+}
+
+func (c *errorClient[T, L]) Apply(ctx context.Context, cfg ApplyConfiguration[T], opts metav1.ApplyOptions) (result T, err error) {
+	return result, c.err
+}
+
+func (c *errorClient[T, L]) ApplyStatus(ctx context.Context, cfg ApplyConfiguration[T], opts metav1.ApplyOptions) (result T, err error) {
+	return result, c.err
+}
+
 func (c *client[T, L]) newT() (result T) {
 	return reflect.New(c.tType).Interface().(T)
 }
@@ -219,3 +295,36 @@ func (c *client[T, L]) Patch(ctx context.Context, name string, pt types.PatchTyp
 		Into(result)
 	return
 }
+
+// Apply performs a Server-Side Apply of cfg and returns the resulting resource.
+func (c *client[T, L]) Apply(ctx context.Context, cfg ApplyConfiguration[T], opts metav1.ApplyOptions) (result T, err error) {
+	return c.apply(ctx, cfg, opts)
+}
+
+// ApplyStatus performs a Server-Side Apply of cfg against the status subresource.
+func (c *client[T, L]) ApplyStatus(ctx context.Context, cfg ApplyConfiguration[T], opts metav1.ApplyOptions) (result T, err error) {
+	return c.apply(ctx, cfg, opts, "status")
+}
+
+func (c *client[T, L]) apply(ctx context.Context, cfg ApplyConfiguration[T], opts metav1.ApplyOptions, subresources ...string) (result T, err error) {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return result, err
+	}
+	var name string
+	if n := cfg.GetName(); n != nil {
+		name = *n
+	}
+	patchOpts := opts.ToPatchOptions()
+	result = c.newT()
+	err = c.restClient.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource(c.resource).
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&patchOpts, c.parameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
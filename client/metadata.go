@@ -0,0 +1,282 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+)
+
+// metadataScheme and metadataParameterCodec only need to know how to encode
+// the meta/v1 query types (ListOptions, GetOptions, ...); they are not tied
+// to any particular resource's scheme.
+var (
+	metadataScheme         = runtime.NewScheme()
+	metadataParameterCodec = runtime.NewParameterCodec(metadataScheme)
+)
+
+func init() {
+	metav1.AddToGroupVersion(metadataScheme, schema.GroupVersion{Version: "v1"})
+}
+
+var partialObjectMetadataKind = schema.GroupVersion{Group: "meta.k8s.io", Version: "v1"}.WithKind("PartialObjectMetadata")
+
+// acceptHeaderFor builds the Accept header used to request a metadata-only
+// representation of a resource, preferring protobuf and falling back to
+// JSON, matching the negotiation upstream client-go uses for its
+// metadata-only clients. The trailing bare application/json lets a server
+// that can't produce the PartialObjectMetadata conversion (common for CRDs
+// served by older or third-party aggregated API servers) return the full
+// object instead of a 406; metav1.PartialObjectMetadata's JSON only pulls
+// apiVersion/kind/metadata and ignores the rest, so it still decodes fine.
+func acceptHeaderFor(as string) string {
+	return fmt.Sprintf(
+		"application/vnd.kubernetes.protobuf;as=%s;v=v1;g=meta.k8s.io,application/json;as=%s;v=v1;g=meta.k8s.io,application/json",
+		as, as,
+	)
+}
+
+// setPartialObjectMetadataGVK stamps the well-known PartialObjectMetadata
+// kind onto obj if the server didn't already include one, so callers can
+// rely on obj.GroupVersionKind() being populated regardless of transport.
+func setPartialObjectMetadataGVK(obj *metav1.PartialObjectMetadata) {
+	if obj.TypeMeta.Kind == "" {
+		obj.TypeMeta.APIVersion, obj.TypeMeta.Kind = partialObjectMetadataKind.ToAPIVersionAndKind()
+	}
+}
+
+// metadataClient implements Interface[*metav1.PartialObjectMetadata, *metav1.PartialObjectMetadataList]
+// against an arbitrary resource by asking the server for its
+// PartialObjectMetadata representation instead of the full object, so
+// callers only pay for labels/annotations/owner refs rather than the whole
+// spec/status.
+type metadataClient struct {
+	restClient rest.Interface
+	resource   string
+	ns         string
+}
+
+// NewMetadataClient returns an Interface that gets/lists/watches the given
+// resource in its PartialObjectMetadata form.
+func NewMetadataClient(restClient rest.Interface, resource, namespace string) Interface[*metav1.PartialObjectMetadata, *metav1.PartialObjectMetadataList] {
+	return &metadataClient{
+		restClient: restClient,
+		resource:   resource,
+		ns:         namespace,
+	}
+}
+
+func (c *metadataClient) Get(ctx context.Context, name string, options metav1.GetOptions) (result *metav1.PartialObjectMetadata, err error) {
+	result = &metav1.PartialObjectMetadata{}
+	err = c.restClient.Get().
+		Namespace(c.ns).
+		Resource(c.resource).
+		Name(name).
+		SetHeader("Accept", acceptHeaderFor("PartialObjectMetadata")).
+		VersionedParams(&options, metadataParameterCodec).
+		Do(ctx).
+		Into(result)
+	setPartialObjectMetadataGVK(result)
+	return
+}
+
+func (c *metadataClient) List(ctx context.Context, opts metav1.ListOptions) (result *metav1.PartialObjectMetadataList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &metav1.PartialObjectMetadataList{}
+	err = c.restClient.Get().
+		Namespace(c.ns).
+		Resource(c.resource).
+		SetHeader("Accept", acceptHeaderFor("PartialObjectMetadataList")).
+		VersionedParams(&opts, metadataParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	for i := range result.Items {
+		setPartialObjectMetadataGVK(&result.Items[i])
+	}
+	return
+}
+
+func (c *metadataClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	w, err := c.restClient.Get().
+		Namespace(c.ns).
+		Resource(c.resource).
+		SetHeader("Accept", acceptHeaderFor("PartialObjectMetadataList")).
+		VersionedParams(&opts, metadataParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newMetadataWatch(w), nil
+}
+
+func (c *metadataClient) Create(ctx context.Context, cr *metav1.PartialObjectMetadata, opts metav1.CreateOptions) (result *metav1.PartialObjectMetadata, err error) {
+	result = &metav1.PartialObjectMetadata{}
+	err = c.restClient.Post().
+		Namespace(c.ns).
+		Resource(c.resource).
+		SetHeader("Accept", acceptHeaderFor("PartialObjectMetadata")).
+		VersionedParams(&opts, metadataParameterCodec).
+		Body(cr).
+		Do(ctx).
+		Into(result)
+	setPartialObjectMetadataGVK(result)
+	return
+}
+
+func (c *metadataClient) Update(ctx context.Context, cr *metav1.PartialObjectMetadata, opts metav1.UpdateOptions) (result *metav1.PartialObjectMetadata, err error) {
+	result = &metav1.PartialObjectMetadata{}
+	err = c.restClient.Put().
+		Namespace(c.ns).
+		Resource(c.resource).
+		Name(cr.GetName()).
+		SetHeader("Accept", acceptHeaderFor("PartialObjectMetadata")).
+		VersionedParams(&opts, metadataParameterCodec).
+		Body(cr).
+		Do(ctx).
+		Into(result)
+	setPartialObjectMetadataGVK(result)
+	return
+}
+
+func (c *metadataClient) UpdateStatus(ctx context.Context, cr *metav1.PartialObjectMetadata, opts metav1.UpdateOptions) (result *metav1.PartialObjectMetadata, err error) {
+	result = &metav1.PartialObjectMetadata{}
+	err = c.restClient.Put().
+		Namespace(c.ns).
+		Resource(c.resource).
+		Name(cr.GetName()).
+		SubResource("status").
+		SetHeader("Accept", acceptHeaderFor("PartialObjectMetadata")).
+		VersionedParams(&opts, metadataParameterCodec).
+		Body(cr).
+		Do(ctx).
+		Into(result)
+	setPartialObjectMetadataGVK(result)
+	return
+}
+
+func (c *metadataClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.restClient.Delete().
+		Namespace(c.ns).
+		Resource(c.resource).
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+func (c *metadataClient) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.restClient.Delete().
+		Namespace(c.ns).
+		Resource(c.resource).
+		VersionedParams(&listOpts, metadataParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+func (c *metadataClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *metav1.PartialObjectMetadata, err error) {
+	result = &metav1.PartialObjectMetadata{}
+	err = c.restClient.Patch(pt).
+		Namespace(c.ns).
+		Resource(c.resource).
+		Name(name).
+		SubResource(subresources...).
+		SetHeader("Accept", acceptHeaderFor("PartialObjectMetadata")).
+		VersionedParams(&opts, metadataParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	setPartialObjectMetadataGVK(result)
+	return
+}
+
+// Apply performs a Server-Side Apply of cfg and returns the resulting
+// PartialObjectMetadata.
+func (c *metadataClient) Apply(ctx context.Context, cfg ApplyConfiguration[*metav1.PartialObjectMetadata], opts metav1.ApplyOptions) (result *metav1.PartialObjectMetadata, err error) {
+	return c.apply(ctx, cfg, opts)
+}
+
+// ApplyStatus performs a Server-Side Apply of cfg against the status subresource.
+func (c *metadataClient) ApplyStatus(ctx context.Context, cfg ApplyConfiguration[*metav1.PartialObjectMetadata], opts metav1.ApplyOptions) (result *metav1.PartialObjectMetadata, err error) {
+	return c.apply(ctx, cfg, opts, "status")
+}
+
+func (c *metadataClient) apply(ctx context.Context, cfg ApplyConfiguration[*metav1.PartialObjectMetadata], opts metav1.ApplyOptions, subresources ...string) (result *metav1.PartialObjectMetadata, err error) {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var name string
+	if n := cfg.GetName(); n != nil {
+		name = *n
+	}
+	patchOpts := opts.ToPatchOptions()
+	result = &metav1.PartialObjectMetadata{}
+	err = c.restClient.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource(c.resource).
+		Name(name).
+		SubResource(subresources...).
+		SetHeader("Accept", acceptHeaderFor("PartialObjectMetadata")).
+		VersionedParams(&patchOpts, metadataParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	setPartialObjectMetadataGVK(result)
+	return
+}
+
+// metadataWatch wraps a watch.Interface so every delivered event whose
+// object is a *metav1.PartialObjectMetadata has its GVK populated, even if
+// the underlying transport omitted it. Events carrying any other object
+// type are passed through unchanged; they are not converted to
+// PartialObjectMetadata.
+type metadataWatch struct {
+	watch.Interface
+	result chan watch.Event
+}
+
+func newMetadataWatch(w watch.Interface) watch.Interface {
+	mw := &metadataWatch{
+		Interface: w,
+		result:    make(chan watch.Event),
+	}
+	go mw.run()
+	return mw
+}
+
+func (w *metadataWatch) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+func (w *metadataWatch) run() {
+	defer close(w.result)
+	for event := range w.Interface.ResultChan() {
+		if obj, ok := event.Object.(*metav1.PartialObjectMetadata); ok {
+			setPartialObjectMetadataGVK(obj)
+		}
+		w.result <- event
+	}
+}
@@ -0,0 +1,220 @@
+// Package controller provides a small workqueue-backed reconcile loop on
+// top of cache.SharedIndexInformer, for writing operators without pulling
+// in controller-runtime.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wzshiming/k8s-client-go/cache"
+	"github.com/wzshiming/k8s-client-go/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	rawcache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Result carries the outcome of a reconcile call.
+type Result struct {
+	// Requeue tells the controller to requeue the same key without waiting
+	// for a new event.
+	Requeue bool
+
+	// RequeueAfter, if non-zero, requeues the key after the given delay
+	// instead of after the rate limiter's backoff.
+	RequeueAfter time.Duration
+}
+
+// ReconcileFunc reconciles the object identified by key, in
+// cache.MetaNamespaceKeyFunc form ("namespace/name", or just "name" for
+// cluster-scoped objects).
+type ReconcileFunc func(ctx context.Context, key string) (Result, error)
+
+// Options configures a Controller.
+type Options struct {
+	// Workers is the number of goroutines processing the workqueue.
+	// Defaults to 1.
+	Workers int
+
+	// RateLimiter controls the backoff applied to requeued keys. Defaults
+	// to workqueue.DefaultControllerRateLimiter().
+	RateLimiter workqueue.RateLimiter
+
+	// MetricsProvider, if set, registers depth/adds/latency/retries/
+	// unfinished-work-seconds gauges for the controller's workqueue
+	// instead of the workqueue package's global default provider.
+	MetricsProvider workqueue.MetricsProvider
+}
+
+// Option mutates Options.
+type Option func(*Options)
+
+// WithWorkers sets the number of worker goroutines.
+func WithWorkers(n int) Option {
+	return func(o *Options) { o.Workers = n }
+}
+
+// WithRateLimiter overrides the default rate limiter.
+func WithRateLimiter(rl workqueue.RateLimiter) Option {
+	return func(o *Options) { o.RateLimiter = rl }
+}
+
+// WithMetricsProvider registers mp's depth/adds/latency/retries/
+// unfinished-work-seconds gauges for this controller's workqueue, e.g. a
+// Prometheus-backed implementation of workqueue.MetricsProvider.
+func WithMetricsProvider(mp workqueue.MetricsProvider) Option {
+	return func(o *Options) { o.MetricsProvider = mp }
+}
+
+// Controller drives a ReconcileFunc off a rate-limited workqueue fed by an
+// informer's add/update/delete events.
+type Controller[T client.Object] struct {
+	name      string
+	informer  cache.SharedIndexInformer[T]
+	reconcile ReconcileFunc
+	queue     workqueue.RateLimitingInterface
+	workers   int
+}
+
+// New builds a Controller that reconciles keys for objects observed by
+// informer. It registers its own event handler on informer to populate the
+// workqueue; callers are still responsible for starting informer.Run.
+func New[T client.Object](name string, informer cache.SharedIndexInformer[T], reconcile ReconcileFunc, opts ...Option) *Controller[T] {
+	options := Options{
+		Workers:     1,
+		RateLimiter: workqueue.DefaultControllerRateLimiter(),
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	c := &Controller[T]{
+		name:      name,
+		informer:  informer,
+		reconcile: reconcile,
+		queue: workqueue.NewRateLimitingQueueWithConfig(options.RateLimiter, workqueue.RateLimitingQueueConfig{
+			Name:            name,
+			MetricsProvider: options.MetricsProvider,
+		}),
+		workers: options.Workers,
+	}
+
+	if _, err := informer.AddEventHandler(simpleHandler[T]{enqueue: c.enqueue}); err != nil {
+		utilruntime.HandleError(fmt.Errorf("%s: failed to register event handler: %w", name, err))
+	}
+
+	return c
+}
+
+// Enqueue adds obj's key to the workqueue directly.
+func (c *Controller[T]) Enqueue(obj T) {
+	c.enqueue(obj)
+}
+
+// EnqueueKey adds an arbitrary "namespace/name" (or "name") key directly,
+// e.g. from a Watches handler for a secondary resource.
+func (c *Controller[T]) EnqueueKey(key string) {
+	c.queue.Add(key)
+}
+
+func (c *Controller[T]) enqueue(obj T) {
+	key, err := rawcache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("%s: couldn't get key for object %+v: %w", c.name, obj, err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run starts the controller's workers and blocks until ctx is canceled.
+func (c *Controller[T]) Run(ctx context.Context) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	if !rawcache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
+		utilruntime.HandleError(fmt.Errorf("%s: timed out waiting for caches to sync", c.name))
+		return
+	}
+
+	for i := 0; i < c.workers; i++ {
+		go wait.Until(func() { c.runWorker(ctx) }, time.Second, ctx.Done())
+	}
+
+	<-ctx.Done()
+}
+
+func (c *Controller[T]) runWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *Controller[T]) processNextWorkItem(ctx context.Context) bool {
+	key, shutdown := c.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	result, err := c.reconcile(ctx, key.(string))
+	switch {
+	case err != nil:
+		c.queue.AddRateLimited(key)
+		utilruntime.HandleError(fmt.Errorf("%s: reconcile %q failed: %w", c.name, key, err))
+	case result.RequeueAfter > 0:
+		c.queue.Forget(key)
+		c.queue.AddAfter(key, result.RequeueAfter)
+	case result.Requeue:
+		c.queue.AddRateLimited(key)
+	default:
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+// Watches registers informer as a secondary resource for ctrl: whenever it
+// observes an add/update/delete, toKeys is called on the affected object
+// and every key it returns is enqueued on ctrl, matching
+// controller-runtime's Watches.
+func Watches[T, O client.Object](ctrl *Controller[T], informer cache.SharedIndexInformer[O], toKeys func(obj O) []string) error {
+	_, err := informer.AddEventHandler(simpleHandler[O]{
+		enqueue: func(obj O) {
+			for _, key := range toKeys(obj) {
+				ctrl.EnqueueKey(key)
+			}
+		},
+	})
+	return err
+}
+
+// Owns registers informer as a resource owned by T: whenever it observes an
+// add/update/delete, the owning object of kind ownerKind (found via
+// metav1.GetControllerOf) is enqueued on ctrl, matching
+// controller-runtime's Owns.
+func Owns[T, O client.Object](ctrl *Controller[T], ownerKind schema.GroupVersionKind, informer cache.SharedIndexInformer[O]) error {
+	apiVersion, kind := ownerKind.ToAPIVersionAndKind()
+	return Watches(ctrl, informer, func(obj O) []string {
+		owner := metav1.GetControllerOfNoCopy(obj)
+		if owner == nil || owner.Kind != kind || owner.APIVersion != apiVersion {
+			return nil
+		}
+		if ns := obj.GetNamespace(); ns != "" {
+			return []string{ns + "/" + owner.Name}
+		}
+		return []string{owner.Name}
+	})
+}
+
+// simpleHandler adapts a single enqueue func into a
+// cache.ResourceEventHandler[T]: every add, update or delete just enqueues
+// the (new) object.
+type simpleHandler[T client.Object] struct {
+	enqueue func(obj T)
+}
+
+func (h simpleHandler[T]) OnAdd(obj T)          { h.enqueue(obj) }
+func (h simpleHandler[T]) OnUpdate(_, newObj T) { h.enqueue(newObj) }
+func (h simpleHandler[T]) OnDelete(obj T)       { h.enqueue(obj) }
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/wzshiming/k8s-client-go/cache"
+	"github.com/wzshiming/k8s-client-go/client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// fakeInformer is a minimal cache.SharedIndexInformer[T] whose only real
+// behavior is remembering the handler registered via AddEventHandler, so a
+// test can drive OnAdd/OnUpdate/OnDelete without a running informer.
+type fakeInformer[T client.Object] struct {
+	handler cache.ResourceEventHandler[T]
+}
+
+func (f *fakeInformer[T]) AddEventHandler(h cache.ResourceEventHandler[T]) (cache.HandlerRegistration, error) {
+	f.handler = h
+	return nil, nil
+}
+
+func (f *fakeInformer[T]) AddEventHandlerWithResyncPeriod(h cache.ResourceEventHandler[T], _ time.Duration) (cache.HandlerRegistration, error) {
+	return f.AddEventHandler(h)
+}
+
+func (f *fakeInformer[T]) HasSynced() bool                 { return true }
+func (f *fakeInformer[T]) LastSyncResourceVersion() string { return "" }
+func (f *fakeInformer[T]) GetIndexer() cache.Indexer[T]    { return nil }
+func (f *fakeInformer[T]) Run(stopCh <-chan struct{})      {}
+
+func noopReconcile(_ context.Context, _ string) (Result, error) { return Result{}, nil }
+
+// TestOwnsEnqueuesOwnerKey exercises the key construction Owns builds on
+// top of Watches: an add/update/delete on the owned (secondary) resource
+// should enqueue the "namespace/name" of its controlling owner, and be a
+// no-op for objects without a controlling owner.
+func TestOwnsEnqueuesOwnerKey(t *testing.T) {
+	ctrlInformer := &fakeInformer[*corev1.ConfigMap]{}
+	ctrl := New[*corev1.ConfigMap]("test", ctrlInformer, noopReconcile)
+
+	podInformer := &fakeInformer[*corev1.Pod]{}
+	deploymentKind := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	if err := Owns[*corev1.ConfigMap](ctrl, deploymentKind, podInformer); err != nil {
+		t.Fatalf("Owns: %v", err)
+	}
+
+	owned := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod-1",
+			Namespace: "ns",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "owner", Controller: boolPtr(true)},
+			},
+		},
+	}
+	podInformer.handler.OnAdd(owned)
+
+	key, shutdown := ctrl.queue.Get()
+	if shutdown {
+		t.Fatal("queue shut down unexpectedly")
+	}
+	if key != "ns/owner" {
+		t.Fatalf("expected key %q, got %q", "ns/owner", key)
+	}
+	ctrl.queue.Done(key)
+
+	unowned := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-2", Namespace: "ns"}}
+	podInformer.handler.OnAdd(unowned)
+
+	if n := ctrl.queue.Len(); n != 0 {
+		t.Fatalf("expected no key enqueued for an unowned object, queue has %d", n)
+	}
+}
+
+func boolPtr(b bool) *bool { return &b }
@@ -0,0 +1,149 @@
+// Package dynamic provides a client.Interface for resources that are only
+// known by their GroupVersionResource at runtime (typically CRDs), so
+// callers don't need generated Go types to list, watch or modify them.
+package dynamic
+
+import (
+	"net/http"
+
+	"github.com/wzshiming/k8s-client-go/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+)
+
+// scheme and parameterCodec only need to know how to encode the meta/v1
+// query types (ListOptions, GetOptions, ...); the resources themselves are
+// unstructured and need no registration.
+var (
+	scheme         = runtime.NewScheme()
+	parameterCodec = runtime.NewParameterCodec(scheme)
+)
+
+func init() {
+	metav1.AddToGroupVersion(scheme, schema.GroupVersion{Version: "v1"})
+}
+
+// Interface builds a client.Interface for an arbitrary resource.
+type Interface interface {
+	Resource(gvr schema.GroupVersionResource) NamespaceableResource
+}
+
+// NamespaceableResource is a client.Interface usable directly for
+// cluster-scoped resources, or scoped to a namespace via Namespace for
+// namespaced ones.
+type NamespaceableResource interface {
+	client.Interface[*unstructured.Unstructured, *UnstructuredList]
+	Namespace(ns string) client.Interface[*unstructured.Unstructured, *UnstructuredList]
+}
+
+// UnstructuredList wraps unstructured.UnstructuredList so it satisfies
+// client.List's metav1.ListMetaAccessor requirement. Unlike
+// metav1.PartialObjectMetadataList, which gets GetListMeta for free by
+// embedding metav1.ListMeta, unstructured.UnstructuredList only exposes the
+// individual ResourceVersion/Continue/... accessors, so it needs the same
+// trick applied explicitly here.
+type UnstructuredList struct {
+	unstructured.UnstructuredList
+}
+
+// GetListMeta implements metav1.ListMetaAccessor.
+func (l *UnstructuredList) GetListMeta() metav1.ListInterface {
+	return &l.UnstructuredList
+}
+
+type dynamicClient struct {
+	restConfig *rest.Config
+	httpClient *http.Client
+}
+
+// NewForConfig returns a dynamic Interface backed by cfg.
+func NewForConfig(cfg *rest.Config) (Interface, error) {
+	httpClient, err := rest.HTTPClientFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &dynamicClient{restConfig: cfg, httpClient: httpClient}, nil
+}
+
+func (d *dynamicClient) Resource(gvr schema.GroupVersionResource) NamespaceableResource {
+	restClient, err := restClientForGVR(d.restConfig, d.httpClient, gvr)
+	if err != nil {
+		return &errorResource{Interface: client.NewErrorClient[*unstructured.Unstructured, *UnstructuredList](err)}
+	}
+	return &namespaceableResource{
+		Interface:  client.NewClient[*unstructured.Unstructured, *UnstructuredList](scheme, parameterCodec, restClient, gvr.Resource, ""),
+		restClient: restClient,
+		resource:   gvr.Resource,
+	}
+}
+
+// restClientForGVR builds a rest.Interface for gvr's group/version whose
+// negotiated serializer decodes responses into *unstructured.Unstructured
+// instead of requiring a registered Go type.
+func restClientForGVR(inConfig *rest.Config, httpClient *http.Client, gvr schema.GroupVersionResource) (rest.Interface, error) {
+	cfg := rest.CopyConfig(inConfig)
+	gv := gvr.GroupVersion()
+	cfg.GroupVersion = &gv
+	if gvr.Group == "" {
+		cfg.APIPath = "/api"
+	} else {
+		cfg.APIPath = "/apis"
+	}
+	cfg.NegotiatedSerializer = unstructuredNegotiatedSerializer{}
+	cfg.ContentType = runtime.ContentTypeJSON
+	if err := rest.SetKubernetesDefaults(cfg); err != nil {
+		return nil, err
+	}
+	return rest.RESTClientForConfigAndClient(cfg, httpClient)
+}
+
+// namespaceableResource is the client.Interface for gvr's cluster-scoped
+// view; Namespace rebuilds it scoped to a namespace.
+type namespaceableResource struct {
+	client.Interface[*unstructured.Unstructured, *UnstructuredList]
+	restClient rest.Interface
+	resource   string
+}
+
+func (r *namespaceableResource) Namespace(ns string) client.Interface[*unstructured.Unstructured, *UnstructuredList] {
+	return client.NewClient[*unstructured.Unstructured, *UnstructuredList](scheme, parameterCodec, r.restClient, r.resource, ns)
+}
+
+// errorResource defers a REST client construction failure to call time.
+type errorResource struct {
+	client.Interface[*unstructured.Unstructured, *UnstructuredList]
+}
+
+func (r *errorResource) Namespace(string) client.Interface[*unstructured.Unstructured, *UnstructuredList] {
+	return r.Interface
+}
+
+// unstructuredNegotiatedSerializer decodes/encodes bodies as
+// *unstructured.Unstructured (or List) via unstructured.UnstructuredJSONScheme
+// rather than a scheme-registered Go type.
+type unstructuredNegotiatedSerializer struct{}
+
+func (unstructuredNegotiatedSerializer) SupportedMediaTypes() []runtime.SerializerInfo {
+	return []runtime.SerializerInfo{
+		{
+			MediaType:        "application/json",
+			MediaTypeType:    "application",
+			MediaTypeSubType: "json",
+			EncodesAsText:    true,
+			Serializer:       unstructured.UnstructuredJSONScheme,
+			PrettySerializer: unstructured.UnstructuredJSONScheme,
+			StrictSerializer: unstructured.UnstructuredJSONScheme,
+		},
+	}
+}
+
+func (unstructuredNegotiatedSerializer) EncoderForVersion(encoder runtime.Encoder, _ runtime.GroupVersioner) runtime.Encoder {
+	return encoder
+}
+
+func (unstructuredNegotiatedSerializer) DecoderToVersion(decoder runtime.Decoder, _ runtime.GroupVersioner) runtime.Decoder {
+	return decoder
+}
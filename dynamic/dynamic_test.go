@@ -0,0 +1,33 @@
+package dynamic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/wzshiming/k8s-client-go/client"
+)
+
+// staticListCheck documents, at compile time, that *UnstructuredList
+// satisfies client.List; this is the exact constraint that was previously
+// unsatisfiable and broke this package (and, via DynamicInformer, cache and
+// controller too).
+var _ client.List = (*UnstructuredList)(nil)
+
+// TestUnstructuredListGetListMeta checks GetListMeta reflects metadata
+// decoded off the wire, the same path client.client[T, L].List relies on
+// via rest.Result.Into.
+func TestUnstructuredListGetListMeta(t *testing.T) {
+	var l UnstructuredList
+	body := []byte(`{"apiVersion":"v1","kind":"List","metadata":{"resourceVersion":"42","continue":"abc"},"items":[]}`)
+	if err := json.Unmarshal(body, &l); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	meta := l.GetListMeta()
+	if got := meta.GetResourceVersion(); got != "42" {
+		t.Fatalf("expected resourceVersion %q, got %q", "42", got)
+	}
+	if got := meta.GetContinue(); got != "abc" {
+		t.Fatalf("expected continue %q, got %q", "abc", got)
+	}
+}
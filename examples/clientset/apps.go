@@ -0,0 +1,36 @@
+package clientset
+
+import (
+	"github.com/wzshiming/k8s-client-go/client"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+)
+
+type AppsV1Interface interface {
+	Deployments(namespace string) client.Interface[*appsv1.Deployment, *appsv1.DeploymentList]
+	StatefulSets(namespace string) client.Interface[*appsv1.StatefulSet, *appsv1.StatefulSetList]
+	DaemonSets(namespace string) client.Interface[*appsv1.DaemonSet, *appsv1.DaemonSetList]
+	ReplicaSets(namespace string) client.Interface[*appsv1.ReplicaSet, *appsv1.ReplicaSetList]
+}
+
+type appsV1Client struct {
+	restClient     rest.Interface
+	parameterCodec runtime.ParameterCodec
+}
+
+func (c *appsV1Client) Deployments(namespace string) client.Interface[*appsv1.Deployment, *appsv1.DeploymentList] {
+	return client.NewClient[*appsv1.Deployment, *appsv1.DeploymentList](scheme, c.parameterCodec, c.restClient, "deployments", namespace)
+}
+
+func (c *appsV1Client) StatefulSets(namespace string) client.Interface[*appsv1.StatefulSet, *appsv1.StatefulSetList] {
+	return client.NewClient[*appsv1.StatefulSet, *appsv1.StatefulSetList](scheme, c.parameterCodec, c.restClient, "statefulsets", namespace)
+}
+
+func (c *appsV1Client) DaemonSets(namespace string) client.Interface[*appsv1.DaemonSet, *appsv1.DaemonSetList] {
+	return client.NewClient[*appsv1.DaemonSet, *appsv1.DaemonSetList](scheme, c.parameterCodec, c.restClient, "daemonsets", namespace)
+}
+
+func (c *appsV1Client) ReplicaSets(namespace string) client.Interface[*appsv1.ReplicaSet, *appsv1.ReplicaSetList] {
+	return client.NewClient[*appsv1.ReplicaSet, *appsv1.ReplicaSetList](scheme, c.parameterCodec, c.restClient, "replicasets", namespace)
+}
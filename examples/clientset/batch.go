@@ -0,0 +1,26 @@
+package clientset
+
+import (
+	"github.com/wzshiming/k8s-client-go/client"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+)
+
+type BatchV1Interface interface {
+	Jobs(namespace string) client.Interface[*batchv1.Job, *batchv1.JobList]
+	CronJobs(namespace string) client.Interface[*batchv1.CronJob, *batchv1.CronJobList]
+}
+
+type batchV1Client struct {
+	restClient     rest.Interface
+	parameterCodec runtime.ParameterCodec
+}
+
+func (c *batchV1Client) Jobs(namespace string) client.Interface[*batchv1.Job, *batchv1.JobList] {
+	return client.NewClient[*batchv1.Job, *batchv1.JobList](scheme, c.parameterCodec, c.restClient, "jobs", namespace)
+}
+
+func (c *batchV1Client) CronJobs(namespace string) client.Interface[*batchv1.CronJob, *batchv1.CronJobList] {
+	return client.NewClient[*batchv1.CronJob, *batchv1.CronJobList](scheme, c.parameterCodec, c.restClient, "cronjobs", namespace)
+}
@@ -1,8 +1,16 @@
 package clientset
 
 import (
+	"net/http"
+
 	"github.com/wzshiming/k8s-client-go/client"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -16,6 +24,11 @@ var scheme = runtime.NewScheme()
 
 func init() {
 	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(appsv1.AddToScheme(scheme))
+	utilruntime.Must(batchv1.AddToScheme(scheme))
+	utilruntime.Must(networkingv1.AddToScheme(scheme))
+	utilruntime.Must(storagev1.AddToScheme(scheme))
+	utilruntime.Must(rbacv1.AddToScheme(scheme))
 }
 
 func NewRestConfigFromKubeconfig(kubeconfig []byte) (*rest.Config, error) {
@@ -38,22 +51,73 @@ func NewForConfig(restConfig *rest.Config) (Interface, error) {
 		return nil, err
 	}
 	codecs := serializer.NewCodecFactory(scheme)
-	restConfig.NegotiatedSerializer = codecs.WithoutConversion()
+	parameterCodec := runtime.NewParameterCodec(scheme)
 
-	restClient, err := rest.RESTClientForConfigAndClient(restConfig, httpClient)
+	coreConfig := rest.CopyConfig(restConfig)
+	if err := setConfigDefaults(coreConfig); err != nil {
+		return nil, err
+	}
+	coreConfig.NegotiatedSerializer = codecs.WithoutConversion()
+	coreClient, err := rest.RESTClientForConfigAndClient(coreConfig, httpClient)
 	if err != nil {
 		return nil, err
 	}
 
-	parameterCodec := runtime.NewParameterCodec(scheme)
+	appsClient, err := restClientForGroupVersion(restConfig, httpClient, codecs, appsv1.SchemeGroupVersion)
+	if err != nil {
+		return nil, err
+	}
+	batchClient, err := restClientForGroupVersion(restConfig, httpClient, codecs, batchv1.SchemeGroupVersion)
+	if err != nil {
+		return nil, err
+	}
+	networkingClient, err := restClientForGroupVersion(restConfig, httpClient, codecs, networkingv1.SchemeGroupVersion)
+	if err != nil {
+		return nil, err
+	}
+	storageClient, err := restClientForGroupVersion(restConfig, httpClient, codecs, storagev1.SchemeGroupVersion)
+	if err != nil {
+		return nil, err
+	}
+	rbacClient, err := restClientForGroupVersion(restConfig, httpClient, codecs, rbacv1.SchemeGroupVersion)
+	if err != nil {
+		return nil, err
+	}
+	discoveryConfig := rest.CopyConfig(restConfig)
+	discoveryConfig.NegotiatedSerializer = codecs.WithoutConversion()
+	discoveryRESTClient, err := rest.RESTClientForConfigAndClient(discoveryConfig, httpClient)
+	if err != nil {
+		return nil, err
+	}
 
 	return &clientset{
 		scheme:         scheme,
 		parameterCodec: parameterCodec,
-		client:         restClient,
+		client:         coreClient,
+		restConfig:     restConfig,
+		httpClient:     httpClient,
+		appsV1:         &appsV1Client{restClient: appsClient, parameterCodec: parameterCodec},
+		batchV1:        &batchV1Client{restClient: batchClient, parameterCodec: parameterCodec},
+		networkingV1:   &networkingV1Client{restClient: networkingClient, parameterCodec: parameterCodec},
+		storageV1:      &storageV1Client{restClient: storageClient, parameterCodec: parameterCodec},
+		rbacV1:         &rbacV1Client{restClient: rbacClient, parameterCodec: parameterCodec},
+		discovery:      &discoveryClient{restClient: discoveryRESTClient},
 	}, nil
 }
 
+// restClientForGroupVersion builds a rest.Interface scoped to the given
+// non-core group/version, which lives under /apis rather than /api.
+func restClientForGroupVersion(restConfig *rest.Config, httpClient *http.Client, codecs serializer.CodecFactory, gv schema.GroupVersion) (rest.Interface, error) {
+	cfg := rest.CopyConfig(restConfig)
+	cfg.GroupVersion = &gv
+	cfg.APIPath = "/apis"
+	cfg.NegotiatedSerializer = codecs.WithoutConversion()
+	if err := rest.SetKubernetesDefaults(cfg); err != nil {
+		return nil, err
+	}
+	return rest.RESTClientForConfigAndClient(cfg, httpClient)
+}
+
 func setConfigDefaults(config *rest.Config) error {
 	config.GroupVersion = &schema.GroupVersion{Group: "", Version: "v1"}
 	if config.APIPath == "" {
@@ -69,12 +133,38 @@ type Interface interface {
 	Pods(namespace string) client.Interface[*corev1.Pod, *corev1.PodList]
 	Services(namespace string) client.Interface[*corev1.Service, *corev1.ServiceList]
 	Endpoints(namespace string) client.Interface[*corev1.Endpoints, *corev1.EndpointsList]
+	Nodes() client.Interface[*corev1.Node, *corev1.NodeList]
+	Events(namespace string) client.Interface[*corev1.Event, *corev1.EventList]
+	ServiceAccounts(namespace string) client.Interface[*corev1.ServiceAccount, *corev1.ServiceAccountList]
+	PersistentVolumes() client.Interface[*corev1.PersistentVolume, *corev1.PersistentVolumeList]
+	PersistentVolumeClaims(namespace string) client.Interface[*corev1.PersistentVolumeClaim, *corev1.PersistentVolumeClaimList]
+
+	AppsV1() AppsV1Interface
+	BatchV1() BatchV1Interface
+	NetworkingV1() NetworkingV1Interface
+	StorageV1() StorageV1Interface
+	RbacV1() RbacV1Interface
+	Discovery() DiscoveryInterface
+
+	// MetadataClient returns a client that reads the given resource in its
+	// PartialObjectMetadata form, for callers that only need labels,
+	// annotations and owner references rather than the full object.
+	MetadataClient(gvr schema.GroupVersionResource, namespace string) client.Interface[*metav1.PartialObjectMetadata, *metav1.PartialObjectMetadataList]
 }
 
 type clientset struct {
 	scheme         *runtime.Scheme
 	parameterCodec runtime.ParameterCodec
 	client         rest.Interface
+	restConfig     *rest.Config
+	httpClient     *http.Client
+
+	appsV1       AppsV1Interface
+	batchV1      BatchV1Interface
+	networkingV1 NetworkingV1Interface
+	storageV1    StorageV1Interface
+	rbacV1       RbacV1Interface
+	discovery    DiscoveryInterface
 }
 
 func (c *clientset) Namespaces() client.Interface[*corev1.Namespace, *corev1.NamespaceList] {
@@ -100,3 +190,74 @@ func (c *clientset) Services(namespace string) client.Interface[*corev1.Service,
 func (c *clientset) Endpoints(namespace string) client.Interface[*corev1.Endpoints, *corev1.EndpointsList] {
 	return client.NewClient[*corev1.Endpoints, *corev1.EndpointsList](c.scheme, c.parameterCodec, c.client, "endpoints", namespace)
 }
+
+func (c *clientset) Nodes() client.Interface[*corev1.Node, *corev1.NodeList] {
+	return client.NewClient[*corev1.Node, *corev1.NodeList](c.scheme, c.parameterCodec, c.client, "nodes", "")
+}
+
+func (c *clientset) Events(namespace string) client.Interface[*corev1.Event, *corev1.EventList] {
+	return client.NewClient[*corev1.Event, *corev1.EventList](c.scheme, c.parameterCodec, c.client, "events", namespace)
+}
+
+func (c *clientset) ServiceAccounts(namespace string) client.Interface[*corev1.ServiceAccount, *corev1.ServiceAccountList] {
+	return client.NewClient[*corev1.ServiceAccount, *corev1.ServiceAccountList](c.scheme, c.parameterCodec, c.client, "serviceaccounts", namespace)
+}
+
+func (c *clientset) PersistentVolumes() client.Interface[*corev1.PersistentVolume, *corev1.PersistentVolumeList] {
+	return client.NewClient[*corev1.PersistentVolume, *corev1.PersistentVolumeList](c.scheme, c.parameterCodec, c.client, "persistentvolumes", "")
+}
+
+func (c *clientset) PersistentVolumeClaims(namespace string) client.Interface[*corev1.PersistentVolumeClaim, *corev1.PersistentVolumeClaimList] {
+	return client.NewClient[*corev1.PersistentVolumeClaim, *corev1.PersistentVolumeClaimList](c.scheme, c.parameterCodec, c.client, "persistentvolumeclaims", namespace)
+}
+
+func (c *clientset) AppsV1() AppsV1Interface {
+	return c.appsV1
+}
+
+func (c *clientset) BatchV1() BatchV1Interface {
+	return c.batchV1
+}
+
+func (c *clientset) NetworkingV1() NetworkingV1Interface {
+	return c.networkingV1
+}
+
+func (c *clientset) StorageV1() StorageV1Interface {
+	return c.storageV1
+}
+
+func (c *clientset) RbacV1() RbacV1Interface {
+	return c.rbacV1
+}
+
+func (c *clientset) Discovery() DiscoveryInterface {
+	return c.discovery
+}
+
+func (c *clientset) MetadataClient(gvr schema.GroupVersionResource, namespace string) client.Interface[*metav1.PartialObjectMetadata, *metav1.PartialObjectMetadataList] {
+	restClient, err := c.restClientForGVR(gvr)
+	if err != nil {
+		return client.NewErrorClient[*metav1.PartialObjectMetadata, *metav1.PartialObjectMetadataList](err)
+	}
+	return client.NewMetadataClient(restClient, gvr.Resource, namespace)
+}
+
+// restClientForGVR builds a rest.Interface scoped to an arbitrary resource,
+// used by MetadataClient, which isn't restricted to the handful of groups
+// this clientset knows about ahead of time.
+func (c *clientset) restClientForGVR(gvr schema.GroupVersionResource) (rest.Interface, error) {
+	cfg := rest.CopyConfig(c.restConfig)
+	gv := gvr.GroupVersion()
+	cfg.GroupVersion = &gv
+	if gvr.Group == "" {
+		cfg.APIPath = "/api"
+	} else {
+		cfg.APIPath = "/apis"
+	}
+	cfg.NegotiatedSerializer = serializer.NewCodecFactory(scheme).WithoutConversion()
+	if err := rest.SetKubernetesDefaults(cfg); err != nil {
+		return nil, err
+	}
+	return rest.RESTClientForConfigAndClient(cfg, c.httpClient)
+}
@@ -0,0 +1,64 @@
+package clientset
+
+import (
+	"context"
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/rest"
+)
+
+// DiscoveryInterface holds the methods that discover server-supported API
+// groups, versions and resources, mirroring the subset of upstream
+// client-go's discovery.DiscoveryInterface this library needs.
+type DiscoveryInterface interface {
+	// ServerVersion retrieves and parses the server's version (git version,
+	// build date, Go version, etc).
+	ServerVersion(ctx context.Context) (*version.Info, error)
+
+	// ServerGroupsAndResources retrieves the API groups listed under /apis
+	// and, for each of their versions, the resources served by the API
+	// server. The legacy core group served under /api is not included.
+	ServerGroupsAndResources(ctx context.Context) (*metav1.APIGroupList, []*metav1.APIResourceList, error)
+}
+
+type discoveryClient struct {
+	restClient rest.Interface
+}
+
+// ServerVersion retrieves and parses the server's version (git version,
+// build date, Go version, etc).
+func (c *discoveryClient) ServerVersion(ctx context.Context) (*version.Info, error) {
+	body, err := c.restClient.Get().AbsPath("/version").Do(ctx).Raw()
+	if err != nil {
+		return nil, err
+	}
+	info := &version.Info{}
+	if err := json.Unmarshal(body, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// ServerGroupsAndResources retrieves the API groups listed under /apis
+// and, for each of their versions, the resources served by the API
+// server. The legacy core group served under /api is not included.
+func (c *discoveryClient) ServerGroupsAndResources(ctx context.Context) (*metav1.APIGroupList, []*metav1.APIResourceList, error) {
+	groups := &metav1.APIGroupList{}
+	if err := c.restClient.Get().AbsPath("/apis").Do(ctx).Into(groups); err != nil {
+		return nil, nil, err
+	}
+
+	resources := make([]*metav1.APIResourceList, 0, len(groups.Groups))
+	for _, group := range groups.Groups {
+		for _, gv := range group.Versions {
+			list := &metav1.APIResourceList{}
+			if err := c.restClient.Get().AbsPath("/apis", gv.GroupVersion).Do(ctx).Into(list); err != nil {
+				return groups, resources, err
+			}
+			resources = append(resources, list)
+		}
+	}
+	return groups, resources, nil
+}
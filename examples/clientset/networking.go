@@ -0,0 +1,26 @@
+package clientset
+
+import (
+	"github.com/wzshiming/k8s-client-go/client"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+)
+
+type NetworkingV1Interface interface {
+	Ingresses(namespace string) client.Interface[*networkingv1.Ingress, *networkingv1.IngressList]
+	NetworkPolicies(namespace string) client.Interface[*networkingv1.NetworkPolicy, *networkingv1.NetworkPolicyList]
+}
+
+type networkingV1Client struct {
+	restClient     rest.Interface
+	parameterCodec runtime.ParameterCodec
+}
+
+func (c *networkingV1Client) Ingresses(namespace string) client.Interface[*networkingv1.Ingress, *networkingv1.IngressList] {
+	return client.NewClient[*networkingv1.Ingress, *networkingv1.IngressList](scheme, c.parameterCodec, c.restClient, "ingresses", namespace)
+}
+
+func (c *networkingV1Client) NetworkPolicies(namespace string) client.Interface[*networkingv1.NetworkPolicy, *networkingv1.NetworkPolicyList] {
+	return client.NewClient[*networkingv1.NetworkPolicy, *networkingv1.NetworkPolicyList](scheme, c.parameterCodec, c.restClient, "networkpolicies", namespace)
+}
@@ -0,0 +1,36 @@
+package clientset
+
+import (
+	"github.com/wzshiming/k8s-client-go/client"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+)
+
+type RbacV1Interface interface {
+	Roles(namespace string) client.Interface[*rbacv1.Role, *rbacv1.RoleList]
+	RoleBindings(namespace string) client.Interface[*rbacv1.RoleBinding, *rbacv1.RoleBindingList]
+	ClusterRoles() client.Interface[*rbacv1.ClusterRole, *rbacv1.ClusterRoleList]
+	ClusterRoleBindings() client.Interface[*rbacv1.ClusterRoleBinding, *rbacv1.ClusterRoleBindingList]
+}
+
+type rbacV1Client struct {
+	restClient     rest.Interface
+	parameterCodec runtime.ParameterCodec
+}
+
+func (c *rbacV1Client) Roles(namespace string) client.Interface[*rbacv1.Role, *rbacv1.RoleList] {
+	return client.NewClient[*rbacv1.Role, *rbacv1.RoleList](scheme, c.parameterCodec, c.restClient, "roles", namespace)
+}
+
+func (c *rbacV1Client) RoleBindings(namespace string) client.Interface[*rbacv1.RoleBinding, *rbacv1.RoleBindingList] {
+	return client.NewClient[*rbacv1.RoleBinding, *rbacv1.RoleBindingList](scheme, c.parameterCodec, c.restClient, "rolebindings", namespace)
+}
+
+func (c *rbacV1Client) ClusterRoles() client.Interface[*rbacv1.ClusterRole, *rbacv1.ClusterRoleList] {
+	return client.NewClient[*rbacv1.ClusterRole, *rbacv1.ClusterRoleList](scheme, c.parameterCodec, c.restClient, "clusterroles", "")
+}
+
+func (c *rbacV1Client) ClusterRoleBindings() client.Interface[*rbacv1.ClusterRoleBinding, *rbacv1.ClusterRoleBindingList] {
+	return client.NewClient[*rbacv1.ClusterRoleBinding, *rbacv1.ClusterRoleBindingList](scheme, c.parameterCodec, c.restClient, "clusterrolebindings", "")
+}
@@ -0,0 +1,21 @@
+package clientset
+
+import (
+	"github.com/wzshiming/k8s-client-go/client"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+)
+
+type StorageV1Interface interface {
+	StorageClasses() client.Interface[*storagev1.StorageClass, *storagev1.StorageClassList]
+}
+
+type storageV1Client struct {
+	restClient     rest.Interface
+	parameterCodec runtime.ParameterCodec
+}
+
+func (c *storageV1Client) StorageClasses() client.Interface[*storagev1.StorageClass, *storagev1.StorageClassList] {
+	return client.NewClient[*storagev1.StorageClass, *storagev1.StorageClassList](scheme, c.parameterCodec, c.restClient, "storageclasses", "")
+}